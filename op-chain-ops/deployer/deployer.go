@@ -8,14 +8,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
-	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
@@ -47,26 +47,104 @@ type Deployment struct {
 	Address  common.Address
 }
 
-type Deployer func(*backends.SimulatedBackend, *bind.TransactOpts, Constructor) (*types.Transaction, error)
+type Deployer func(*simulated.Backend, *bind.TransactOpts, Constructor) (*types.Transaction, error)
 
-// NewBackend returns a SimulatedBackend suitable for EVM simulation, without L2 features.
+// BlobDeployer is Deployer's counterpart for contracts that need to be deployed via an
+// EIP-4844 blob transaction, e.g. to unit test BLOBHASH/BLOBBASEFEE handling. Only meaningful
+// against an L1 backend (NewL1Backend*), since the L2 fork schedule predates 4844.
+//
+// Unlike Deployer, whose opts arrive with a nonce already assigned by DeployBatch,
+// BlobDeployer implementations are responsible for setting the returned BlobTx's Nonce
+// themselves (e.g. via backend.Client().PendingNonceAt): DeployBlob signs and sends the BlobTx
+// as returned, does not read opts.Nonce, and does not assign nonces on the caller's behalf.
+// Deploying more than one blob-tx contract without doing so will reuse the same nonce and fail.
+type BlobDeployer func(*simulated.Backend, *bind.TransactOpts, Constructor) (*types.BlobTx, error)
+
+// NewBackend returns a simulated.Backend suitable for EVM simulation, without L2 features.
 // It has up to Shanghai enabled.
 // The returned backend should be closed after use.
-func NewBackend() (*backends.SimulatedBackend, error) {
-	backend, err := NewBackendWithGenesisTimestamp(ChainID, 0, nil)
-	return backend, err
+//
+// Deprecated: use NewL2Backend, which this now aliases. Kept for existing callers that only
+// need the pre-Ecotone L2 fork schedule.
+func NewBackend() (*simulated.Backend, error) {
+	return NewL2Backend()
 }
 
-// NewBackendWithChainIDAndPredeploys returns a SimulatedBackend suitable for EVM simulation, without L2 features.
+// NewBackendWithChainIDAndPredeploys returns a simulated.Backend suitable for EVM simulation, without L2 features.
 // It has up to Shanghai enabled, and allows for the configuration of the network's chain ID and predeploys.
 // The returned backend should be closed after use.
-func NewBackendWithChainIDAndPredeploys(chainID *big.Int, predeploys map[string]*common.Address) (*backends.SimulatedBackend, error) {
-	backend, err := NewBackendWithGenesisTimestamp(chainID, 0, predeploys)
-	return backend, err
+//
+// Deprecated: use NewL2BackendWithChainIDAndPredeploys, which this now aliases.
+func NewBackendWithChainIDAndPredeploys(chainID *big.Int, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return NewL2BackendWithChainIDAndPredeploys(chainID, predeploys)
+}
+
+// Deprecated: use NewL2BackendWithGenesisTimestamp, which this now aliases.
+func NewBackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return NewL2BackendWithGenesisTimestamp(chainID, ts, predeploys)
+}
+
+// NewL2Backend returns a simulated.Backend with the pre-Ecotone L2 fork schedule (up to
+// Shanghai). Tests that exercise pre-Ecotone behavior should use this so they stay deterministic
+// as new L1 forks are added to NewL1Backend.
+// The returned backend should be closed after use.
+func NewL2Backend() (*simulated.Backend, error) {
+	return NewL2BackendWithGenesisTimestamp(ChainID, 0, nil)
+}
+
+// NewL2BackendWithChainIDAndPredeploys is NewL2Backend but allows configuring the chain ID and predeploys.
+func NewL2BackendWithChainIDAndPredeploys(chainID *big.Int, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return NewL2BackendWithGenesisTimestamp(chainID, 0, predeploys)
+}
+
+// NewL2BackendWithGenesisTimestamp is NewL2Backend but allows configuring the genesis timestamp too.
+func NewL2BackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return newBackend(chainID, ts, predeploys, l2ChainConfig(chainID))
+}
+
+// NewL1Backend returns a simulated.Backend with the L1 fork schedule: Cancun and Prague
+// enabled from genesis, so 4844 blob transactions
+// and EIP-1153 transient storage are usable when deploying or testing contracts via DeployBlob.
+// The returned backend should be closed after use.
+func NewL1Backend() (*simulated.Backend, error) {
+	return NewL1BackendWithGenesisTimestamp(ChainID, 0, nil)
+}
+
+// NewL1BackendWithChainIDAndPredeploys is NewL1Backend but allows configuring the chain ID and predeploys.
+func NewL1BackendWithChainIDAndPredeploys(chainID *big.Int, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return NewL1BackendWithGenesisTimestamp(chainID, 0, predeploys)
 }
 
-func NewBackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[string]*common.Address) (*backends.SimulatedBackend, error) {
-	chainConfig := params.ChainConfig{
+// NewL1BackendWithGenesisTimestamp is NewL1Backend but allows configuring the genesis timestamp too.
+func NewL1BackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[string]*common.Address) (*simulated.Backend, error) {
+	return newBackend(chainID, ts, predeploys, l1ChainConfig(chainID))
+}
+
+func newBackend(chainID *big.Int, ts uint64, predeploys map[string]*common.Address, chainConfig *params.ChainConfig) (*simulated.Backend, error) {
+	alloc, err := genesisAlloc(predeploys)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &core.Genesis{
+		Config:     chainConfig,
+		Timestamp:  ts,
+		Difficulty: big.NewInt(0),
+		Alloc:      alloc,
+		GasLimit:   30_000_000,
+	}
+	// alloc here is dead: simulated.NewBackend derives a default genesis from it, but
+	// withGenesis's option runs after and unconditionally replaces ethConf.Genesis (which
+	// already embeds this same alloc) with ours. It's still required syntactically, since
+	// simulated.NewBackend's first parameter isn't optional.
+	return simulated.NewBackend(alloc, withGenesis(genesis)), nil
+}
+
+// baseChainConfig holds the fork schedule common to both the L1 and L2 simulated backends: every
+// fork through Shanghai is active from genesis, along with the PoS-style manual block production
+// this package has always relied on.
+func baseChainConfig(chainID *big.Int) *params.ChainConfig {
+	return &params.ChainConfig{
 		ChainID:             chainID,
 		HomesteadBlock:      big.NewInt(0),
 		DAOForkBlock:        nil,
@@ -91,7 +169,26 @@ func NewBackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[
 		TerminalTotalDifficultyPassed: true,
 		ShanghaiTime:                  u64ptr(0),
 	}
+}
+
+// l2ChainConfig is the pre-Ecotone L2 fork schedule: up to Shanghai, nothing past it, so tests
+// written against it stay deterministic as new L1-only forks land in l1ChainConfig.
+func l2ChainConfig(chainID *big.Int) *params.ChainConfig {
+	return baseChainConfig(chainID)
+}
 
+// l1ChainConfig is the L1 fork schedule: everything in l2ChainConfig plus Cancun (4844 blob
+// transactions, EIP-1153 transient storage) and Prague, both active from genesis.
+func l1ChainConfig(chainID *big.Int) *params.ChainConfig {
+	cfg := baseChainConfig(chainID)
+	cfg.CancunTime = u64ptr(0)
+	cfg.PragueTime = u64ptr(0)
+	return cfg
+}
+
+// genesisAlloc builds the genesis allocation shared by every simulated backend flavor: the
+// funded test account plus the requested predeploys, keyed by their deployed bytecode.
+func genesisAlloc(predeploys map[string]*common.Address) (core.GenesisAlloc, error) {
 	alloc := core.GenesisAlloc{
 		crypto.PubkeyToAddress(TestKey.PublicKey): core.GenesisAccount{
 			Balance: thousandETH,
@@ -106,37 +203,216 @@ func NewBackendWithGenesisTimestamp(chainID *big.Int, ts uint64, predeploys map[
 			Code: bytecode,
 		}
 	}
+	return alloc, nil
+}
 
-	cfg := ethconfig.Defaults
-	cfg.Preimages = true
-	cfg.Genesis = &core.Genesis{
-		Config:     &chainConfig,
-		Timestamp:  ts,
-		Difficulty: big.NewInt(0),
-		Alloc:      alloc,
-		GasLimit:   30_000_000,
+// withGenesis overrides the genesis that simulated.NewBackend would otherwise derive from the
+// alloc passed to it, letting us pin the exact chain config (fork schedule, chain ID, timestamp)
+// this package has always guaranteed to callers. It also keeps preimage recording enabled, as
+// the old backends.SimulatedBackend-based setup did.
+func withGenesis(genesis *core.Genesis) func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+	return func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		ethConf.Genesis = genesis
+		ethConf.Preimages = true
 	}
-	return backends.NewSimulatedBackendFromConfig(cfg), nil
 }
 
-func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb Deployer) ([]Deployment, error) {
-	results := make([]Deployment, len(constructors))
+// Deploy deploys every constructor, assuming none of them depend on another's address. It is
+// DeployBatch with no dependencies, so every constructor is submitted in a single round. See
+// DeployBatch to express dependencies between constructors.
+func Deploy(backend *simulated.Backend, constructors []Constructor, cb Deployer) ([]Deployment, error) {
+	return DeployBatch(backend, constructors, nil, cb)
+}
+
+// DeployBatch deploys every constructor, submitting as many independent transactions as
+// possible per simulated.Backend.Commit() cycle instead of committing once per constructor.
+// Per-constructor commits would dominate test setup time for the Optimism predeploy set, where
+// most contracts don't depend on each other's addresses.
+//
+// deps, if non-nil, gives each constructor's list of indices (into constructors) that must
+// already be deployed before it submits, e.g. when one contract's constructor args reference
+// another's address. deps[i] may be nil or omitted (deps shorter than constructors) for a
+// constructor with no dependencies. Pass a nil deps slice when every constructor is independent.
+//
+// Results are returned in input order regardless of submission or landing order.
+func DeployBatch(backend *simulated.Backend, constructors []Constructor, deps [][]int, cb Deployer) ([]Deployment, error) {
+	n := len(constructors)
+	results := make([]Deployment, n)
 
 	opts, err := bind.NewKeyedTransactorWithChainID(TestKey, ChainID)
 	if err != nil {
 		return nil, err
 	}
-
 	opts.GasLimit = 15_000_000
 
 	ctx := context.Background()
+	db := asDeployBackend(backend)
+
+	nonce, err := db.PendingNonceAt(ctx, opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch starting nonce: %w", err)
+	}
+
+	rounds, err := scheduleRounds(n, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, round := range rounds {
+		txs := make([]*types.Transaction, len(round))
+		for j, i := range round {
+			txOpts := *opts
+			txOpts.Nonce = new(big.Int).SetUint64(nonce)
+			nonce++
+
+			tx, err := cb(backend, &txOpts, constructors[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", constructors[i].Name, err)
+			}
+			txs[j] = tx
+		}
+
+		roundResults, err := waitRoundMined(ctx, backend, db, round, txs, constructors)
+		if err != nil {
+			return nil, err
+		}
+		for i, d := range roundResults {
+			results[i] = d
+		}
+	}
+
+	return results, nil
+}
+
+// waitRoundMined commits blocks until every tx in the round has a receipt. A single Commit()
+// isn't enough in general: every tx in a round shares the same sender, and once the miner's gas
+// pool for that sender drops below a later tx's gas limit, go-ethereum pops the rest of that
+// sender's nonce-ordered queue out of the block entirely rather than reordering around it
+// (exactly the case this helper is built to handle, since a realistically-sized round like the
+// Optimism predeploy set won't always fit in one block). Results are keyed by the original
+// constructor index.
+func waitRoundMined(ctx context.Context, backend *simulated.Backend, db simulatedDeployBackend, round []int, txs []*types.Transaction, constructors []Constructor) (map[int]Deployment, error) {
+	pending := make(map[int]*types.Transaction, len(round))
+	for j, i := range round {
+		pending[i] = txs[j]
+	}
+
+	results := make(map[int]Deployment, len(round))
+	queryTicker := time.NewTicker(100 * time.Millisecond)
+	defer queryTicker.Stop()
+
+	for len(pending) > 0 {
+		backend.Commit()
+
+		for i, tx := range pending {
+			receipt, err := db.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				continue
+			}
+			addr := receipt.ContractAddress
+			if addr == (common.Address{}) {
+				return nil, fmt.Errorf("no address for %s", constructors[i].Name)
+			}
+			code, err := db.CodeAt(ctx, addr, nil)
+			if err != nil {
+				return nil, fmt.Errorf("%s: cannot fetch code: %w", constructors[i].Name, err)
+			}
+			if len(code) == 0 {
+				return nil, fmt.Errorf("%s: %w", constructors[i].Name, bind.ErrNoCodeAfterDeploy)
+			}
+			results[i] = Deployment{
+				Name:     constructors[i].Name,
+				Bytecode: code,
+				Address:  addr,
+			}
+			delete(pending, i)
+		}
+
+		if len(pending) == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-queryTicker.C:
+		}
+	}
+
+	return results, nil
+}
+
+// scheduleRounds groups constructor indices into dependency-respecting rounds: round 0 holds
+// every index with no dependencies, round 1 holds every index whose dependencies are all
+// satisfied by round 0, and so on. Constructors within a round have no ordering requirement
+// between each other and are all submitted before the round's first Commit().
+func scheduleRounds(n int, deps [][]int) ([][]int, error) {
+	landed := make([]bool, n)
+	remaining := n
+	var rounds [][]int
+
+	ready := func(i int) bool {
+		if i >= len(deps) {
+			return true
+		}
+		for _, d := range deps[i] {
+			// An out-of-range dependency can never land, so treat it as never-satisfied: i
+			// simply never becomes ready, and the round loop below reports it the same way it
+			// reports a genuine cycle.
+			if d < 0 || d >= n || !landed[d] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for remaining > 0 {
+		var round []int
+		for i := 0; i < n; i++ {
+			if !landed[i] && ready(i) {
+				round = append(round, i)
+			}
+		}
+		if len(round) == 0 {
+			return nil, errors.New("unsatisfiable or cyclic constructor dependency")
+		}
+		for _, i := range round {
+			landed[i] = true
+		}
+		remaining -= len(round)
+		rounds = append(rounds, round)
+	}
+	return rounds, nil
+}
+
+// DeployBlob is Deploy's counterpart for BlobDeployer constructors: it signs and sends an
+// EIP-4844 blob transaction for each entry instead of a plain transaction, so callers can
+// exercise contracts that read BLOBHASH/BLOBBASEFEE without hand-rolling a parallel harness.
+// It must be run against a backend from NewL1Backend (or another Cancun-enabled backend).
+func DeployBlob(backend *simulated.Backend, constructors []Constructor, cb BlobDeployer) ([]Deployment, error) {
+	results := make([]Deployment, len(constructors))
+
+	opts, err := bind.NewKeyedTransactorWithChainID(TestKey, ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	db := asDeployBackend(backend)
 	for i, deployment := range constructors {
-		tx, err := cb(backend, opts, deployment)
+		blobTx, err := cb(backend, opts, deployment)
 		if err != nil {
 			return nil, err
 		}
 
-		r, err := WaitMined(ctx, backend, tx)
+		tx, err := opts.Signer(opts.From, types.NewTx(blobTx))
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to sign blob tx: %w", deployment.Name, err)
+		}
+		if err := db.SendTransaction(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%s: failed to send blob tx: %w", deployment.Name, err)
+		}
+
+		r, err := WaitMined(ctx, db, tx)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", deployment.Name, err)
 		}
@@ -145,12 +421,12 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 		if addr == (common.Address{}) {
 			return nil, fmt.Errorf("no address for %s", deployment.Name)
 		}
-		code, err := backend.CodeAt(context.Background(), addr, nil)
-		if len(code) == 0 {
-			return nil, fmt.Errorf("no code found for %s", deployment.Name)
-		}
+		code, err := db.CodeAt(ctx, addr, nil)
 		if err != nil {
-			return nil, fmt.Errorf("cannot fetch code for %s", deployment.Name)
+			return nil, fmt.Errorf("%s: cannot fetch code: %w", deployment.Name, err)
+		}
+		if len(code) == 0 {
+			return nil, fmt.Errorf("%s: %w", deployment.Name, bind.ErrNoCodeAfterDeploy)
 		}
 		results[i] = Deployment{
 			Name:     deployment.Name,
@@ -162,10 +438,11 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 	return results, nil
 }
 
-// DeployWithDeterministicDeployer deploys a smart contract on a simulated Ethereum blockchain using a deterministic deployment proxy (Arachnid's).
+// DeployWithDeterministicDeployer deploys a smart contract on a simulated Ethereum blockchain
+// using a deterministic deployment proxy (Arachnid's) and bindings.GetDeploymentSalt as the salt.
 //
 // Parameters:
-// - backend: A pointer to backends.SimulatedBackend, representing the simulated Ethereum blockchain.
+// - backend: A pointer to simulated.Backend, representing the simulated Ethereum blockchain.
 // Expected to have Arachnid's proxy deployer predeploys at 0x4e59b44847b379578588920cA78FbF26c0B4956C, NewBackendWithChainIDAndPredeploys handles this for you.
 // - contractName: A string representing the name of the contract to be deployed.
 //
@@ -173,10 +450,26 @@ func Deploy(backend *backends.SimulatedBackend, constructors []Constructor, cb D
 // - []byte: The deployed bytecode of the contract.
 // - error: An error object indicating any issues encountered during the deployment process.
 //
-// The function logs a fatal error and exits if there are any issues with transaction mining, if the deployment fails,
-// or if the deployed bytecode is not found at the computed address.
-func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contractName string) ([]byte, error) {
-	cid, err := backend.ChainID(context.Background())
+// See DeployWithDeterministicDeployerAndSalt to deploy multiple instances of the same contract at
+// distinct deterministic addresses, and EnsureDeployedWithDeterministicDeployer to skip
+// redeploying a contract that already has code at its predicted address.
+func DeployWithDeterministicDeployer(backend *simulated.Backend, contractName string) ([]byte, error) {
+	salt, err := bindings.GetDeploymentSalt(contractName)
+	if err != nil {
+		return nil, err
+	}
+	return DeployWithDeterministicDeployerAndSalt(backend, contractName, salt)
+}
+
+// DeployWithDeterministicDeployerAndSalt is DeployWithDeterministicDeployer, but with a
+// caller-supplied salt instead of bindings.GetDeploymentSalt, so the same contract can be
+// deployed at multiple distinct deterministic addresses -- a common pattern when wiring up test
+// fixtures for systems like Permit2 or EntryPoint.
+func DeployWithDeterministicDeployerAndSalt(backend *simulated.Backend, contractName string, salt []byte) ([]byte, error) {
+	db := asDeployBackend(backend)
+	ctx := context.Background()
+
+	cid, err := db.ChainID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -190,28 +483,26 @@ func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contrac
 		return nil, err
 	}
 
-	deploymentSalt, err := bindings.GetDeploymentSalt(contractName)
-	if err != nil {
-		return nil, err
-	}
-
 	initBytecode, err := bindings.GetInitBytecode(contractName)
 	if err != nil {
 		return nil, err
 	}
 
-	transactor, err := bindings.NewDeterministicDeploymentProxyTransactor(common.BytesToAddress(deployerAddress), backend)
+	transactor, err := bindings.NewDeterministicDeploymentProxyTransactor(common.BytesToAddress(deployerAddress), db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize deployment proxy transactor at %s: %w", deployerAddress, err)
 	}
 
 	backend.Commit() // make sure at least one block is written or the below Fallback call can fail
-	tx, err := transactor.Fallback(opts, append(deploymentSalt, initBytecode...))
+	tx, err := transactor.Fallback(opts, append(salt, initBytecode...))
 	if err != nil {
 		return nil, fmt.Errorf("Fallback failed: %w", err)
 	}
 
-	receipt, err := WaitMined(context.Background(), backend, tx)
+	// The proxy's Fallback call is a plain call (to the proxy), not a contract creation, so it
+	// can't go through WaitDeployed: the deployed address is derived from CREATE2, not the
+	// receipt's ContractAddress.
+	receipt, err := WaitMined(ctx, db, tx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
 	}
@@ -219,25 +510,83 @@ func DeployWithDeterministicDeployer(backend *backends.SimulatedBackend, contrac
 		return nil, errors.New("failed to deploy contract using proxy deployer")
 	}
 
-	address := create2Address(
-		deployerAddress,
-		deploymentSalt,
-		initBytecode,
-	)
+	address := Create2Address(deployerAddress, salt, initBytecode)
 
-	code, _ := backend.CodeAt(context.Background(), address, nil)
+	code, err := db.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: cannot fetch code: %w", contractName, err)
+	}
 	if len(code) == 0 {
-		return nil, fmt.Errorf("no code found for %s at: %s", contractName, address)
+		return nil, fmt.Errorf("%s at %s: %w", contractName, address, bind.ErrNoCodeAfterDeploy)
 	}
 
 	return code, nil
 }
 
+// EnsureDeployedWithDeterministicDeployer is DeployWithDeterministicDeployer, but idempotent: it
+// checks CodeAt the predicted address first and returns that code immediately if the contract is
+// already deployed, instead of unconditionally resubmitting the CREATE2 transaction.
+func EnsureDeployedWithDeterministicDeployer(backend *simulated.Backend, contractName string) ([]byte, error) {
+	salt, err := bindings.GetDeploymentSalt(contractName)
+	if err != nil {
+		return nil, err
+	}
+	return EnsureDeployedWithDeterministicDeployerAndSalt(backend, contractName, salt)
+}
+
+// EnsureDeployedWithDeterministicDeployerAndSalt is EnsureDeployedWithDeterministicDeployer, but
+// with a caller-supplied salt instead of bindings.GetDeploymentSalt; see
+// DeployWithDeterministicDeployerAndSalt.
+func EnsureDeployedWithDeterministicDeployerAndSalt(backend *simulated.Backend, contractName string, salt []byte) ([]byte, error) {
+	address, err := predictDeterministicDeployAddress(contractName, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	db := asDeployBackend(backend)
+	if code, err := db.CodeAt(context.Background(), address, nil); err == nil && len(code) > 0 {
+		return code, nil
+	}
+
+	return DeployWithDeterministicDeployerAndSalt(backend, contractName, salt)
+}
+
+// PredictDeterministicDeployAddress returns the address contractName's bytecode will be deployed
+// to by the deterministic deployment proxy, using bindings.GetDeploymentSalt as the salt. It does
+// no on-chain interaction, so it can be used to bake a predicted address into a genesis file
+// before the contract -- or even a backend -- exists.
+func PredictDeterministicDeployAddress(contractName string) (common.Address, error) {
+	salt, err := bindings.GetDeploymentSalt(contractName)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return predictDeterministicDeployAddress(contractName, salt)
+}
+
+// PredictDeterministicDeployAddressWithSalt is PredictDeterministicDeployAddress, but with a
+// caller-supplied salt instead of bindings.GetDeploymentSalt; see
+// DeployWithDeterministicDeployerAndSalt.
+func PredictDeterministicDeployAddressWithSalt(contractName string, salt []byte) (common.Address, error) {
+	return predictDeterministicDeployAddress(contractName, salt)
+}
+
+func predictDeterministicDeployAddress(contractName string, salt []byte) (common.Address, error) {
+	deployerAddress, err := bindings.GetDeployerAddress(contractName)
+	if err != nil {
+		return common.Address{}, err
+	}
+	initBytecode, err := bindings.GetInitBytecode(contractName)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return Create2Address(deployerAddress, salt, initBytecode), nil
+}
+
 func u64ptr(n uint64) *uint64 {
 	return &n
 }
 
-// create2Address computes the Ethereum address for a contract created using the CREATE2 opcode.
+// Create2Address computes the Ethereum address for a contract created using the CREATE2 opcode.
 //
 // The CREATE2 opcode allows for more deterministic address generation in Ethereum, as it computes the
 // address based on the creator's address, a salt value, and the contract's initialization code.
@@ -249,7 +598,7 @@ func u64ptr(n uint64) *uint64 {
 //
 // Returns:
 // - common.Address: The Ethereum address calculated using the CREATE2 opcode logic.
-func create2Address(creatorAddress, salt, initCode []byte) common.Address {
+func Create2Address(creatorAddress, salt, initCode []byte) common.Address {
 	payload := append([]byte{0xff}, creatorAddress...)
 	payload = append(payload, salt...)
 	initCodeHash := crypto.Keccak256(initCode)
@@ -258,27 +607,76 @@ func create2Address(creatorAddress, salt, initCode []byte) common.Address {
 	return common.BytesToAddress(crypto.Keccak256(payload)[12:])
 }
 
-// WaitMined waits for tx to be mined on the blockchain with a simulated backend, calling Commit()
-// on the backend before attemping to fetch the transaction receipt in a wait loop.  It stops
-// waiting when the context is canceled.
-func WaitMined(ctx context.Context, b *backends.SimulatedBackend, tx *types.Transaction) (*types.Receipt, error) {
-	queryTicker := time.NewTicker(100 * time.Millisecond)
-	defer queryTicker.Stop()
+// DeployBackend is the read surface the wait-for-mining helpers below need: enough to fetch a
+// transaction's receipt and the code at an address. A real *ethclient.Client satisfies this
+// directly, so WaitMined and WaitDeployed work unmodified against live RPC endpoints (devnet,
+// Anvil, Tenderly forks), not just our own simulated backend. Deploy, DeployBatch, DeployBlob
+// and the deterministic-deployer helpers take a *simulated.Backend and do not accept a
+// DeployBackend.
+type DeployBackend interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
 
-	for {
-		// Call commit with each try since earlier calls may have preceded the tx reaching the
-		// txpool.
-		b.Commit()
-		receipt, err := b.TransactionReceipt(ctx, tx.Hash())
-		if err == nil {
-			return receipt, nil
-		}
-		// Wait for the next round.
-		log.Warn("waiting on receipt due to error", "err", err)
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-queryTicker.C:
-		}
+// committer is implemented by backends that need an explicit Commit() to advance the chain
+// (namely simulated.Backend) rather than landing blocks on their own like a live RPC endpoint.
+type committer interface {
+	Commit() common.Hash
+}
+
+// simulatedDeployBackend adapts a *simulated.Backend into a DeployBackend (and a committer) by
+// embedding both the backend itself, for Commit(), and its Client(), for the ethclient surface.
+type simulatedDeployBackend struct {
+	*simulated.Backend
+	simulated.Client
+}
+
+// asDeployBackend wraps a simulated backend so it can be passed to WaitMined/WaitDeployed, and
+// to anything in op-bindings that expects a bind.ContractBackend, while still driving Commit().
+func asDeployBackend(b *simulated.Backend) simulatedDeployBackend {
+	return simulatedDeployBackend{Backend: b, Client: b.Client()}
+}
+
+// WaitMined waits for tx to be mined, polling via bind.WaitMined. If b also implements Commit()
+// (true for our simulated backend), it drives block production in the background for the
+// duration of the wait; against a live DeployBackend, blocks land on their own.
+func WaitMined(ctx context.Context, b DeployBackend, tx *types.Transaction) (*types.Receipt, error) {
+	stop := driveCommits(ctx, b)
+	defer stop()
+	return bind.WaitMined(ctx, b, tx)
+}
+
+// WaitDeployed waits for a contract-creation tx to be mined and returns its address, failing
+// with bind.ErrNoCodeAfterDeploy if no code ends up at that address. It drives block production
+// the same way WaitMined does.
+func WaitDeployed(ctx context.Context, b DeployBackend, tx *types.Transaction) (common.Address, error) {
+	stop := driveCommits(ctx, b)
+	defer stop()
+	return bind.WaitDeployed(ctx, b, tx)
+}
+
+// driveCommits starts a background goroutine calling Commit() on b at the same cadence the old
+// hand-rolled wait loop used, if b supports it, and returns a function to stop it. It is a no-op
+// against backends that land blocks on their own.
+func driveCommits(ctx context.Context, b DeployBackend) func() {
+	c, ok := b.(committer)
+	if !ok {
+		return func() {}
 	}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				c.Commit()
+			}
+		}
+	}()
+	return func() { close(done) }
 }
@@ -0,0 +1,66 @@
+package deployer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleRounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		deps    [][]int
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "independent set lands in a single round",
+			n:    3,
+			deps: nil,
+			want: [][]int{{0, 1, 2}},
+		},
+		{
+			name: "linear chain lands one index per round",
+			n:    3,
+			deps: [][]int{nil, {0}, {1}},
+			want: [][]int{{0}, {1}, {2}},
+		},
+		{
+			name: "diamond dependency groups siblings into the same round",
+			n:    4,
+			deps: [][]int{nil, {0}, {0}, {1, 2}},
+			want: [][]int{{0}, {1, 2}, {3}},
+		},
+		{
+			name:    "self-reference is reported as unsatisfiable",
+			n:       2,
+			deps:    [][]int{{0}, nil},
+			wantErr: true,
+		},
+		{
+			name:    "forward-reference cycle is reported as unsatisfiable",
+			n:       2,
+			deps:    [][]int{{1}, {0}},
+			wantErr: true,
+		},
+		{
+			name:    "out-of-range dependency is reported as unsatisfiable instead of panicking",
+			n:       2,
+			deps:    [][]int{{5}, nil},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scheduleRounds(tt.n, tt.deps)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}